@@ -0,0 +1,109 @@
+package yookassa
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIError is returned for every non-2xx response from the YooKassa API. It
+// captures both the HTTP status and YooKassa's own error envelope, so
+// callers don't have to re-read resp.Body themselves to find out what
+// went wrong.
+type APIError struct {
+	// StatusCode of the HTTP response.
+	StatusCode int `json:"-"`
+
+	// RequestID is YooKassa's X-Request-Id header, useful when reporting
+	// an issue to YooKassa support.
+	RequestID string `json:"-"`
+
+	// Type is always "error".
+	Type string `json:"type"`
+
+	// ID uniquely identifies this particular error occurrence.
+	ID string `json:"id"`
+
+	// Code is YooKassa's machine-readable error code, e.g. "invalid_request".
+	Code string `json:"code"`
+
+	// Description is a human-readable explanation of the error.
+	Description string `json:"description"`
+
+	// Parameter is the name of the request parameter that caused the
+	// error, if any.
+	Parameter string `json:"parameter,omitempty"`
+}
+
+func (e *APIError) Error() string {
+	if e.Parameter != "" {
+		return fmt.Sprintf("yookassa: %d %s: %s (parameter: %s)", e.StatusCode, e.Code, e.Description, e.Parameter)
+	}
+	return fmt.Sprintf("yookassa: %d %s: %s", e.StatusCode, e.Code, e.Description)
+}
+
+// IsRetryable reports whether the request that produced e is safe to retry:
+// 5xx responses and 429 Too Many Requests.
+func (e *APIError) IsRetryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// IsIdempotencyConflict reports whether e was caused by reusing an
+// Idempotence-Key with a request body different from the original call.
+func (e *APIError) IsIdempotencyConflict() bool {
+	return e.StatusCode == http.StatusBadRequest && e.Code == "idempotent_key_conflict"
+}
+
+// Is lets errors.Is match e against one of the sentinel errors below by
+// comparing YooKassa's error code, e.g.:
+//
+//	if errors.Is(err, yookassa.ErrNotFound) { ... }
+func (e *APIError) Is(target error) bool {
+	sentinel, ok := target.(*sentinelError)
+	if !ok {
+		return false
+	}
+	return e.Code == sentinel.code
+}
+
+type sentinelError struct {
+	code string
+}
+
+func (s *sentinelError) Error() string {
+	return fmt.Sprintf("yookassa: %s", s.code)
+}
+
+// Sentinel errors for the YooKassa error codes callers most commonly need
+// to branch on. Match them with errors.Is, not ==: the actual error is
+// always an *APIError carrying the same Code.
+var (
+	ErrNotFound           = &sentinelError{code: "not_found"}
+	ErrInvalidRequest     = &sentinelError{code: "invalid_request"}
+	ErrInvalidCredentials = &sentinelError{code: "invalid_credentials"}
+	ErrForbidden          = &sentinelError{code: "forbidden"}
+	ErrTooManyRequests    = &sentinelError{code: "too_many_requests"}
+)
+
+// parseAPIError decodes resp's body into an *APIError. It consumes and
+// closes resp.Body.
+func parseAPIError(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("yookassa: can't read error response body: %w", err)
+	}
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	if err := json.Unmarshal(body, apiErr); err != nil {
+		apiErr.Description = string(body)
+	}
+
+	return apiErr
+}
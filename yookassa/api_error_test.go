@@ -0,0 +1,55 @@
+package yookassa
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseAPIError(t *testing.T) {
+	resp := httptest.NewRecorder()
+	resp.Header().Set("X-Request-Id", "req-1")
+	resp.WriteHeader(http.StatusNotFound)
+	resp.Body.WriteString(`{"type": "error", "id": "err-1", "code": "not_found", "description": "payment not found"}`)
+
+	err := parseAPIError(resp.Result())
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", apiErr.StatusCode)
+	}
+	if apiErr.RequestID != "req-1" {
+		t.Errorf("expected request ID req-1, got %s", apiErr.RequestID)
+	}
+	if !errors.Is(apiErr, ErrNotFound) {
+		t.Errorf("expected errors.Is to match ErrNotFound")
+	}
+	if apiErr.IsRetryable() {
+		t.Errorf("expected a 404 not to be retryable")
+	}
+}
+
+func TestAPIErrorIsRetryable(t *testing.T) {
+	cases := []struct {
+		statusCode int
+		retryable  bool
+	}{
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusBadRequest, false},
+		{http.StatusNotFound, false},
+	}
+
+	for _, c := range cases {
+		apiErr := &APIError{StatusCode: c.statusCode}
+		if apiErr.IsRetryable() != c.retryable {
+			t.Errorf("status %d: expected IsRetryable() == %v", c.statusCode, c.retryable)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package yookassa
+
+import (
+	"math/rand"
+	"time"
+)
+
+// maxBackoffDelay caps the exponential backoff delay so that a caller
+// configuring a generous MaxRetries (not unreasonable for a background
+// payout/refund worker) can't drive base << attempt into an int64 overflow.
+const maxBackoffDelay = 30 * time.Second
+
+// backoffDelay returns the exponential backoff delay for the given attempt
+// (0-indexed), as base * 2^attempt capped at maxBackoffDelay, plus up to
+// 20% jitter, so that many clients retrying the same failing endpoint
+// don't all wake up at once.
+func backoffDelay(base time.Duration, attempt int) time.Duration {
+	delay := maxBackoffDelay
+
+	// Guard the shift itself, not just its result: for a large enough
+	// attempt, base << attempt overflows int64 and wraps to a negative or
+	// tiny value before it can ever be compared against maxBackoffDelay.
+	if attempt < 32 {
+		if shifted := base << attempt; shifted > 0 && shifted < maxBackoffDelay {
+			delay = shifted
+		}
+	}
+
+	jitter := time.Duration(rand.Int63n(int64(delay)/5 + 1))
+	return delay + jitter
+}
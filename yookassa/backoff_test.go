@@ -0,0 +1,36 @@
+package yookassa
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDelayNeverPanicsOrExceedsCap(t *testing.T) {
+	base := 200 * time.Millisecond
+
+	for attempt := 0; attempt < 100; attempt++ {
+		delay := backoffDelay(base, attempt)
+
+		if delay <= 0 {
+			t.Fatalf("attempt %d: expected a positive delay, got %s", attempt, delay)
+		}
+		// Up to 20% jitter is added on top of the cap.
+		if delay > maxBackoffDelay+maxBackoffDelay/5 {
+			t.Errorf("attempt %d: delay %s exceeds maxBackoffDelay %s plus jitter", attempt, delay, maxBackoffDelay)
+		}
+	}
+}
+
+func TestBackoffDelayGrowsBeforeHittingCap(t *testing.T) {
+	base := 10 * time.Millisecond
+
+	first := backoffDelay(base, 0)
+	if first < base {
+		t.Errorf("expected attempt 0 delay to be at least base %s, got %s", base, first)
+	}
+
+	capped := backoffDelay(base, 50)
+	if capped < maxBackoffDelay {
+		t.Errorf("expected a far-out attempt to hit the cap of %s, got %s", maxBackoffDelay, capped)
+	}
+}
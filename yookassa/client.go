@@ -3,32 +3,111 @@ package yookassa
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 const (
 	BaseURL = "https://api.yookassa.ru/v3/"
+
+	defaultTimeout     = 30 * time.Second
+	defaultMaxRetries  = 2
+	defaultBackoffBase = 200 * time.Millisecond
 )
 
+// ClientOptions configures a Client beyond the bare account credentials.
+// The zero value is valid and falls back to the package defaults.
+type ClientOptions struct {
+	// HTTPClient is the http.Client used for every request. Defaults to a
+	// client with Timeout if nil.
+	HTTPClient *http.Client
+
+	// Timeout bounds a single HTTP round trip. It does NOT bound retries:
+	// each retry attempt rebuilds and re-sends the request, again bounded
+	// by Timeout, so the wall-clock time for a call can reach roughly
+	// (MaxRetries+1)*Timeout plus backoff delays. For an overall deadline
+	// across every attempt, use a context with a deadline/timeout when
+	// calling a handler method (e.g. client.Payments().CreatePayment(ctx, ...)).
+	// Ignored when HTTPClient is set explicitly: set the timeout on
+	// HTTPClient yourself in that case. Defaults to 30s.
+	Timeout time.Duration
+
+	// MaxRetries is how many additional attempts are made after the first
+	// one for retryable responses (5xx, 429) and network errors. Zero
+	// means the default of 2. A negative value disables retries.
+	MaxRetries int
+
+	// BackoffBase is the base delay for exponential backoff between
+	// retries; actual delays are BackoffBase * 2^attempt, capped at 30s,
+	// plus jitter. Defaults to 200ms.
+	BackoffBase time.Duration
+
+	// Logger, if set, is called with a one-line message before each retry
+	// attempt. Useful for surfacing retries in application logs.
+	Logger func(format string, args ...interface{})
+}
+
+func (o ClientOptions) withDefaults() ClientOptions {
+	if o.HTTPClient == nil {
+		timeout := o.Timeout
+		if timeout <= 0 {
+			timeout = defaultTimeout
+		}
+		o.HTTPClient = &http.Client{Timeout: timeout}
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = defaultMaxRetries
+	} else if o.MaxRetries < 0 {
+		o.MaxRetries = 0
+	}
+	if o.BackoffBase <= 0 {
+		o.BackoffBase = defaultBackoffBase
+	}
+	if o.Logger == nil {
+		o.Logger = func(string, ...interface{}) {}
+	}
+	return o
+}
+
 // Client works with YooMoney API.
 type Client struct {
 	client    http.Client
 	accountId string
 	secretKey string
+	opts      ClientOptions
 }
 
+// NewClient creates a Client with the default ClientOptions: a 30s request
+// timeout and up to 2 retries on 5xx/429 responses and network errors.
 func NewClient(accountId string, secretKey string) *Client {
+	return NewClientWithOptions(accountId, secretKey, ClientOptions{})
+}
+
+// NewClientWithHttp creates a Client that issues every request through
+// httpClient, e.g. to share connection pooling or instrumentation with the
+// rest of the application.
+func NewClientWithHttp(accountId string, secretKey string, httpClient *http.Client) *Client {
+	return NewClientWithOptions(accountId, secretKey, ClientOptions{HTTPClient: httpClient})
+}
+
+// NewClientWithOptions creates a Client with explicit ClientOptions.
+func NewClientWithOptions(accountId string, secretKey string, opts ClientOptions) *Client {
+	opts = opts.withDefaults()
 	return &Client{
-		client:    http.Client{},
+		client:    *opts.HTTPClient,
 		accountId: accountId,
 		secretKey: secretKey,
+		opts:      opts,
 	}
 }
 
 func (c *Client) makeRequest(
+	ctx context.Context,
 	method string,
 	endpoint string,
 	body []byte,
@@ -37,40 +116,131 @@ func (c *Client) makeRequest(
 ) (*http.Response, error) {
 	uri := fmt.Sprintf("%s%s", BaseURL, endpoint)
 
-	req, err := http.NewRequest(method, uri, bytes.NewBuffer(body))
-	if err != nil {
-		return nil, err
-	}
-
 	if idempotencyKey == "" {
 		idempotencyKey = uuid.NewString()
 	}
 
-	if method == http.MethodPost {
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("Idempotence-Key", idempotencyKey)
-	}
+	var resp *http.Response
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, uri, bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
+
+		if method == http.MethodPost {
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Idempotence-Key", idempotencyKey)
+		}
+
+		req.SetBasicAuth(c.accountId, c.secretKey)
+
+		if params != nil {
+			q := req.URL.Query()
+			for paramName, paramVal := range params {
+				q.Add(paramName, fmt.Sprintf("%v", paramVal))
+			}
+			req.URL.RawQuery = q.Encode()
+		}
+
+		resp, err = c.client.Do(req)
+		if err == nil && !isRetryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		if attempt >= c.opts.MaxRetries || !shouldRetry(ctx, err) {
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
 
-	req.SetBasicAuth(c.accountId, c.secretKey)
+		delay := backoffDelay(c.opts.BackoffBase, attempt)
+		c.opts.Logger("yookassa: retrying %s %s after error %v (attempt %d/%d, waiting %s)",
+			method, endpoint, err, attempt+1, c.opts.MaxRetries, delay)
 
-	if params != nil {
-		q := req.URL.Query()
-		for paramName, paramVal := range params {
-			q.Add(paramName, fmt.Sprintf("%v", paramVal))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
 		}
-		req.URL.RawQuery = q.Encode()
 	}
+}
 
-	resp, err := c.client.Do(req)
+// do is like makeRequest but also translates a non-2xx response into an
+// *APIError, so handlers never have to re-read resp.Body to find out what
+// went wrong.
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	body []byte,
+	params map[string]interface{},
+	idempotencyKey string,
+) (*http.Response, error) {
+	resp, err := c.makeRequest(ctx, method, endpoint, body, params, idempotencyKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	return nil, parseAPIError(resp)
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+func shouldRetry(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	// err == nil here means the request went through but returned a
+	// retryable status code; any non-nil err is a network/transport error,
+	// which is also retryable.
+	return true
+}
+
+// Payments returns a PaymentHandler bound to c.
+func (c *Client) Payments() *PaymentHandler {
+	return NewPaymentHandler(c)
+}
+
+// Refunds returns a RefundHandler bound to c.
+func (c *Client) Refunds() *RefundHandler {
+	return NewRefundHandler(c)
+}
+
+// Payouts returns a PayoutHandler bound to c.
+func (c *Client) Payouts() *PayoutHandler {
+	return NewPayoutHandler(c)
+}
+
+// Deals returns a DealHandler bound to c.
+func (c *Client) Deals() *DealHandler {
+	return NewDealHandler(c)
 }
 
+// YkClient is a package-level Client shared by every caller.
+//
+// Deprecated: a single shared Client can't serve more than one YooMoney
+// account, and InitClient has no synchronization, so concurrent
+// initialization races. Create a *Client with NewClient per tenant instead,
+// and obtain handlers from it directly, e.g. client.Payments().
 var YkClient *Client
 
+// InitClient sets the package-level YkClient.
+//
+// Deprecated: use NewClient and pass the resulting *Client to each handler
+// (or through the Client.Payments/Refunds/Payouts/Deals accessors) instead.
 func InitClient(accountId string, secretKey string) {
 	YkClient = NewClient(accountId, secretKey)
 }
@@ -0,0 +1,141 @@
+package yookassa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// redirectTransport rewrites every outgoing request to target a test
+// server, regardless of the URL the client built it for (BaseURL is a
+// package constant, not something a test can override).
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t *redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func testClient(t *testing.T, server *httptest.Server, opts ClientOptions) *Client {
+	t.Helper()
+
+	target, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("can't parse test server URL: %v", err)
+	}
+
+	opts.HTTPClient = &http.Client{Transport: &redirectTransport{target: target}}
+	return NewClientWithOptions("account", "secret", opts)
+}
+
+func TestMakeRequestRetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{MaxRetries: 2, BackoffBase: time.Millisecond})
+
+	resp, err := client.makeRequest(context.Background(), http.MethodGet, "payments/1", nil, nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected eventual 200, got %d", resp.StatusCode)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("expected 3 attempts (1 + 2 retries), got %d", got)
+	}
+}
+
+func TestMakeRequestPreservesIdempotencyKeyAcrossRetries(t *testing.T) {
+	var keys []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotence-Key"))
+		if len(keys) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{MaxRetries: 2, BackoffBase: time.Millisecond})
+
+	resp, err := client.makeRequest(context.Background(), http.MethodPost, "payments", []byte(`{}`), nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(keys))
+	}
+	if keys[0] == "" {
+		t.Fatalf("expected a generated idempotency key, got empty string")
+	}
+	for _, key := range keys[1:] {
+		if key != keys[0] {
+			t.Errorf("expected the same idempotency key on every retry, got %v", keys)
+		}
+	}
+}
+
+func TestDoReturnsRetryableAPIErrorAfterExhaustingRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"type": "error", "code": "internal_server_error", "description": "boom"}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{MaxRetries: 1, BackoffBase: time.Millisecond})
+
+	_, err := client.do(context.Background(), http.MethodGet, "payments/1", nil, nil, "")
+	if err == nil {
+		t.Fatalf("expected an error once retries are exhausted")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if !apiErr.IsRetryable() {
+		t.Errorf("expected a 500 to be classified as retryable")
+	}
+}
+
+func TestMakeRequestStopsRetryingWhenContextIsCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{MaxRetries: 100, BackoffBase: 50 * time.Millisecond})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.makeRequest(ctx, http.MethodGet, "payments/1", nil, nil, "")
+	if err == nil {
+		t.Fatalf("expected a context error")
+	}
+	if ctx.Err() == nil {
+		t.Fatalf("expected the context to have expired")
+	}
+}
@@ -0,0 +1,10 @@
+package yoocommon
+
+// Amount represents a monetary amount together with its currency.
+type Amount struct {
+	// Value of the amount, e.g. "10.00".
+	Value string `json:"value"`
+
+	// Currency is the three-letter ISO 4217 currency code, e.g. "RUB".
+	Currency string `json:"currency"`
+}
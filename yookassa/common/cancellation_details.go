@@ -0,0 +1,10 @@
+package yoocommon
+
+// CancellationDetails explains who canceled an operation and why.
+type CancellationDetails struct {
+	// Party that canceled the operation: yoo_money, payment_network, or merchant.
+	Party string `json:"party,omitempty"`
+
+	// Reason for the cancellation.
+	Reason string `json:"reason,omitempty"`
+}
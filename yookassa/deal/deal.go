@@ -0,0 +1,81 @@
+// Package yoodeal describes the entities for working with YooMoney Safe
+// Deals: marketplace escrows that split a payment's funds between the
+// platform and one or more sellers, paid out independently of when the
+// underlying payments succeed.
+package yoodeal
+
+import "time"
+
+// Status is the lifecycle status of a Deal.
+type Status string
+
+const (
+	StatusOpened Status = "opened"
+	StatusClosed Status = "closed"
+)
+
+// FeeMoment is when YooMoney charges its commission on a Deal.
+type FeeMoment string
+
+const (
+	// FeeMomentPaymentSucceeded charges the commission as soon as a payment within the deal succeeds.
+	FeeMomentPaymentSucceeded FeeMoment = "payment_succeeded"
+
+	// FeeMomentDealClosed charges the commission only once the deal is closed.
+	FeeMomentDealClosed FeeMoment = "deal_closed"
+)
+
+// DealBalance is an amount of money associated with a Deal: either the
+// balance currently held in it, or the amount already paid out of it.
+type DealBalance struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+// Deal is a YooMoney Safe Deal.
+type Deal struct {
+	// Deal ID in YooMoney.
+	ID string `json:"id,omitempty"`
+
+	// Deal type. Currently only "safe_deal".
+	Type string `json:"type,omitempty"`
+
+	// FeeMoment controls when YooMoney's commission is charged.
+	FeeMoment FeeMoment `json:"fee_moment,omitempty"`
+
+	// Deal status. Possible values: opened and closed.
+	Status Status `json:"status,omitempty"`
+
+	// Balance currently held in the deal, available for payout to sellers.
+	Balance *DealBalance `json:"balance,omitempty"`
+
+	// PayoutBalance is the amount already paid out of the deal.
+	PayoutBalance *DealBalance `json:"payout_balance,omitempty"`
+
+	// Description of the deal (maximum 128 characters).
+	Description string `json:"description,omitempty" binding:"max=128"`
+
+	// Any additional data required for processing the deal.
+	Metadata interface{} `json:"metadata,omitempty"`
+
+	// Time the deal was created, in ISO 8601 format.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Time the deal expires, in ISO 8601 format.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// The attribute of a test transaction.
+	Test bool `json:"test,omitempty"`
+}
+
+// DealParameters is the request body for creating a Deal.
+type DealParameters struct {
+	// FeeMoment controls when YooMoney's commission is charged. Defaults to payment_succeeded.
+	FeeMoment FeeMoment `json:"fee_moment,omitempty"`
+
+	// Description of the deal (maximum 128 characters).
+	Description string `json:"description,omitempty" binding:"max=128"`
+
+	// Any additional data required for processing the deal.
+	Metadata interface{} `json:"metadata,omitempty"`
+}
@@ -0,0 +1,115 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	yoodeal "github.com/ilya2204/yookassa-sdk-go/yookassa/deal"
+	yoopayout "github.com/ilya2204/yookassa-sdk-go/yookassa/payout"
+)
+
+// DealHandler works with the /deals endpoint, and with paying sellers out of
+// a deal's balance.
+type DealHandler struct {
+	client         *Client
+	payouts        *PayoutHandler
+	idempotencyKey string
+}
+
+// NewDealHandler creates a DealHandler bound to client.
+func NewDealHandler(client *Client) *DealHandler {
+	return &DealHandler{client: client, payouts: NewPayoutHandler(client)}
+}
+
+// SetIdempotencyKey returns a copy of h that sends idempotencyKey on its
+// next request; h itself is left untouched so the key never leaks into a
+// later, unrelated call.
+func (h *DealHandler) SetIdempotencyKey(idempotencyKey string) *DealHandler {
+	clone := *h
+	clone.idempotencyKey = idempotencyKey
+	return &clone
+}
+
+// CreateDeal opens a new Safe Deal.
+func (h *DealHandler) CreateDeal(ctx context.Context, params *yoodeal.DealParameters) (*yoodeal.Deal, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("yookassa: can't marshal deal parameters: %w", err)
+	}
+
+	resp, err := h.client.do(ctx, http.MethodPost, "deals", body, nil, h.idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeDeal(resp)
+}
+
+// GetDeal fetches a deal by ID.
+func (h *DealHandler) GetDeal(ctx context.Context, dealID string) (*yoodeal.Deal, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, fmt.Sprintf("deals/%s", dealID), nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeDeal(resp)
+}
+
+// DealListParameters filters and paginates ListDeals.
+type DealListParameters struct {
+	Limit  int
+	Cursor string
+}
+
+func (p DealListParameters) toQueryParams() map[string]interface{} {
+	params := make(map[string]interface{})
+	if p.Limit > 0 {
+		params["limit"] = p.Limit
+	}
+	if p.Cursor != "" {
+		params["cursor"] = p.Cursor
+	}
+	return params
+}
+
+// DealList is a page of deals, as returned by ListDeals.
+type DealList struct {
+	Type       string         `json:"type"`
+	Items      []yoodeal.Deal `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
+// ListDeals lists deals, filtered and paginated by params.
+func (h *DealHandler) ListDeals(ctx context.Context, params DealListParameters) (*DealList, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, "deals", nil, params.toQueryParams(), "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var list DealList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode deal list: %w", err)
+	}
+
+	return &list, nil
+}
+
+// CreateSellerPayout pays a seller out of dealID's balance. It's a thin
+// wrapper over PayoutHandler.CreatePayout that pins the payout to the deal.
+func (h *DealHandler) CreateSellerPayout(ctx context.Context, dealID string, params *yoopayout.PayoutParameters) (*yoopayout.Payout, error) {
+	params.Deal = &yoopayout.PayoutDeal{ID: dealID}
+	return h.payouts.SetIdempotencyKey(h.idempotencyKey).CreatePayout(ctx, params)
+}
+
+func decodeDeal(resp *http.Response) (*yoodeal.Deal, error) {
+	var deal yoodeal.Deal
+	if err := json.NewDecoder(resp.Body).Decode(&deal); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode deal: %w", err)
+	}
+	return &deal, nil
+}
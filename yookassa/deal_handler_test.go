@@ -0,0 +1,154 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+	yoodeal "github.com/ilya2204/yookassa-sdk-go/yookassa/deal"
+	yoopayout "github.com/ilya2204/yookassa-sdk-go/yookassa/payout"
+)
+
+func TestDealHandlerIdempotencyKey(t *testing.T) {
+	idempotencyKey := uuid.NewString()
+
+	dealHandler := NewDealHandler(nil)
+
+	dealHandler.SetIdempotencyKey(idempotencyKey)
+
+	if dealHandler.idempotencyKey != "" {
+		t.Errorf("Idempotency key must be set only for one request")
+	}
+
+	if dealHandler.SetIdempotencyKey(idempotencyKey).idempotencyKey != idempotencyKey {
+		t.Errorf("Wrong behaviour of idempotency key: %s", idempotencyKey)
+	}
+
+	if dealHandler.idempotencyKey != "" {
+		t.Errorf("Idempotency key must be set only for one request")
+	}
+}
+
+func TestCreateDealPostsExpectedBody(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("server: can't decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "deal-1", "type": "safe_deal", "status": "opened"}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	params := &yoodeal.DealParameters{
+		FeeMoment:   yoodeal.FeeMomentDealClosed,
+		Description: "order #1",
+	}
+
+	deal, err := client.Deals().CreateDeal(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("expected POST, got %s", gotMethod)
+	}
+	if gotPath != "/v3/deals" {
+		t.Errorf("expected path /v3/deals, got %s", gotPath)
+	}
+	if gotBody["fee_moment"] != "deal_closed" {
+		t.Errorf("expected fee_moment deal_closed, got %v (request body: %v)", gotBody["fee_moment"], gotBody)
+	}
+
+	if deal.ID != "deal-1" {
+		t.Errorf("expected deal id deal-1, got %s", deal.ID)
+	}
+}
+
+func TestListDealsSendsQueryParams(t *testing.T) {
+	var gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"type": "list", "items": []}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	_, err := client.Deals().ListDeals(context.Background(), DealListParameters{Limit: 7, Cursor: "c2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query, err := url.ParseQuery(gotQuery)
+	if err != nil {
+		t.Fatalf("can't parse query: %v", err)
+	}
+	if query.Get("limit") != "7" {
+		t.Errorf("expected limit=7, got %v", query)
+	}
+	if query.Get("cursor") != "c2" {
+		t.Errorf("expected cursor=c2, got %v", query)
+	}
+}
+
+func TestCreateSellerPayoutPinsDealID(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("server: can't decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "payout-1", "status": "pending"}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	params := &yoopayout.PayoutParameters{
+		Amount: &yoocommon.Amount{Value: "50.00", Currency: "RUB"},
+	}
+
+	payout, err := client.Deals().CreateSellerPayout(context.Background(), "deal-1", params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotPath != "/v3/payouts" {
+		t.Errorf("expected path /v3/payouts, got %s", gotPath)
+	}
+
+	deal, ok := gotBody["deal"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a deal object in the request body, got %v", gotBody["deal"])
+	}
+	if deal["id"] != "deal-1" {
+		t.Errorf("expected deal.id deal-1, got %v", deal["id"])
+	}
+
+	if payout.ID != "payout-1" {
+		t.Errorf("expected payout id payout-1, got %s", payout.ID)
+	}
+}
@@ -0,0 +1,20 @@
+package yoopayment
+
+// ThreeDSecure carries the result of 3-D Secure authentication for a
+// bank_card payment.
+type ThreeDSecure struct {
+	Applied bool `json:"applied"`
+}
+
+// AuthorizationDetails carries the card network's authorization data for a
+// bank_card payment.
+type AuthorizationDetails struct {
+	// RRN is the Retrieval Reference Number assigned by the card network.
+	RRN string `json:"rrn,omitempty"`
+
+	// AuthCode is the authorization code assigned by the issuing bank.
+	AuthCode string `json:"auth_code,omitempty"`
+
+	// ThreeDSecure carries the 3-D Secure authentication result.
+	ThreeDSecure *ThreeDSecure `json:"three_d_secure,omitempty"`
+}
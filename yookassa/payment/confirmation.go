@@ -0,0 +1,140 @@
+package yoopayment
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ConfirmationType identifies the scenario the user confirms a Payment with.
+type ConfirmationType string
+
+const (
+	ConfirmationTypeRedirect          ConfirmationType = "redirect"
+	ConfirmationTypeQR                ConfirmationType = "qr"
+	ConfirmationTypeEmbedded          ConfirmationType = "embedded"
+	ConfirmationTypeExternal          ConfirmationType = "external"
+	ConfirmationTypeMobileApplication ConfirmationType = "mobile_application"
+)
+
+// Confirmer is implemented by every concrete confirmation scenario
+// (ConfirmationRedirect, ConfirmationQR, ...). Payment.UnmarshalJSON picks
+// the concrete type from the confirmation.type discriminator.
+type Confirmer interface {
+	GetType() ConfirmationType
+}
+
+type baseConfirmation struct {
+	Type ConfirmationType `json:"type"`
+}
+
+func (c baseConfirmation) GetType() ConfirmationType {
+	return c.Type
+}
+
+// ConfirmationRedirect asks the user to follow ConfirmationURL to confirm the payment.
+type ConfirmationRedirect struct {
+	baseConfirmation
+	Enforce         bool   `json:"enforce,omitempty"`
+	Locale          string `json:"locale,omitempty"`
+	ReturnURL       string `json:"return_url,omitempty"`
+	ConfirmationURL string `json:"confirmation_url,omitempty"`
+}
+
+// MarshalJSON fills in Type, so a caller building a ConfirmationRedirect by
+// hand (the usual way to populate PaymentParameters.Confirmation) doesn't
+// have to set the discriminator itself.
+func (c ConfirmationRedirect) MarshalJSON() ([]byte, error) {
+	type alias ConfirmationRedirect
+	if c.Type == "" {
+		c.Type = ConfirmationTypeRedirect
+	}
+	return json.Marshal(alias(c))
+}
+
+// ConfirmationQR asks the user to scan ConfirmationData as a QR code.
+type ConfirmationQR struct {
+	baseConfirmation
+	ConfirmationData string `json:"confirmation_data,omitempty"`
+}
+
+// MarshalJSON fills in Type; see ConfirmationRedirect.MarshalJSON.
+func (c ConfirmationQR) MarshalJSON() ([]byte, error) {
+	type alias ConfirmationQR
+	if c.Type == "" {
+		c.Type = ConfirmationTypeQR
+	}
+	return json.Marshal(alias(c))
+}
+
+// ConfirmationEmbedded asks the caller to render ConfirmationToken in an embedded widget.
+type ConfirmationEmbedded struct {
+	baseConfirmation
+	Locale            string `json:"locale,omitempty"`
+	ConfirmationToken string `json:"confirmation_token,omitempty"`
+}
+
+// MarshalJSON fills in Type; see ConfirmationRedirect.MarshalJSON.
+func (c ConfirmationEmbedded) MarshalJSON() ([]byte, error) {
+	type alias ConfirmationEmbedded
+	if c.Type == "" {
+		c.Type = ConfirmationTypeEmbedded
+	}
+	return json.Marshal(alias(c))
+}
+
+// ConfirmationExternal means confirmation happens outside of YooMoney's own
+// widgets, e.g. in a messenger bot. Set Type to ConfirmationTypeMobileApplication
+// instead of the default ConfirmationTypeExternal when confirming in-app.
+type ConfirmationExternal struct {
+	baseConfirmation
+}
+
+// MarshalJSON fills in Type with ConfirmationTypeExternal unless the caller
+// already set a more specific type (ConfirmationTypeMobileApplication); see
+// ConfirmationRedirect.MarshalJSON.
+func (c ConfirmationExternal) MarshalJSON() ([]byte, error) {
+	type alias ConfirmationExternal
+	if c.Type == "" {
+		c.Type = ConfirmationTypeExternal
+	}
+	return json.Marshal(alias(c))
+}
+
+// UnknownConfirmation is decoded when confirmation.type doesn't match any
+// confirmation scenario this SDK models yet. Raw carries the undecoded
+// confirmation object so callers can still inspect it.
+type UnknownConfirmation struct {
+	baseConfirmation
+	Raw json.RawMessage `json:"-"`
+}
+
+func newConfirmation(raw []byte) (Confirmer, error) {
+	var discriminator struct {
+		Type ConfirmationType `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return nil, fmt.Errorf("yoopayment: can't read confirmation.type: %w", err)
+	}
+
+	var confirmation Confirmer
+	switch discriminator.Type {
+	case ConfirmationTypeRedirect:
+		confirmation = &ConfirmationRedirect{}
+	case ConfirmationTypeQR:
+		confirmation = &ConfirmationQR{}
+	case ConfirmationTypeEmbedded:
+		confirmation = &ConfirmationEmbedded{}
+	case ConfirmationTypeExternal, ConfirmationTypeMobileApplication:
+		confirmation = &ConfirmationExternal{}
+	default:
+		// YooKassa may add confirmation scenarios this SDK doesn't model
+		// yet; decode what we can instead of failing the whole Payment.
+		confirmation = &UnknownConfirmation{Raw: append(json.RawMessage(nil), raw...)}
+	}
+
+	if err := json.Unmarshal(raw, confirmation); err != nil {
+		return nil, fmt.Errorf("yoopayment: can't decode %q confirmation: %w", discriminator.Type, err)
+	}
+
+	return confirmation, nil
+}
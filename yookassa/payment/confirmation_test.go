@@ -0,0 +1,64 @@
+package yoopayment
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewConfirmationUnknownType(t *testing.T) {
+	raw := []byte(`{"type": "future_confirmation", "confirmation_url": "https://example.com"}`)
+
+	confirmation, err := newConfirmation(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unknown, ok := confirmation.(*UnknownConfirmation)
+	if !ok {
+		t.Fatalf("expected *UnknownConfirmation, got %T", confirmation)
+	}
+	if unknown.GetType() != "future_confirmation" {
+		t.Errorf("expected type future_confirmation, got %s", unknown.GetType())
+	}
+	if len(unknown.Raw) == 0 {
+		t.Errorf("expected Raw to carry the undecoded confirmation object")
+	}
+}
+
+func TestConfirmationMarshalJSONFillsInType(t *testing.T) {
+	confirmation := &ConfirmationRedirect{ReturnURL: "https://example.com/return"}
+
+	data, err := json.Marshal(confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded["type"] != string(ConfirmationTypeRedirect) {
+		t.Errorf("expected type %q, got %v (full json: %s)", ConfirmationTypeRedirect, decoded["type"], data)
+	}
+	if decoded["return_url"] != "https://example.com/return" {
+		t.Errorf("expected return_url to round-trip, got %v", decoded["return_url"])
+	}
+}
+
+func TestConfirmationMarshalJSONKeepsExplicitType(t *testing.T) {
+	confirmation := &ConfirmationExternal{}
+	confirmation.Type = ConfirmationTypeMobileApplication
+
+	data, err := json.Marshal(confirmation)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded["type"] != string(ConfirmationTypeMobileApplication) {
+		t.Errorf("expected type %q, got %v (full json: %s)", ConfirmationTypeMobileApplication, decoded["type"], data)
+	}
+}
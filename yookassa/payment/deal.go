@@ -0,0 +1,51 @@
+package yoopayment
+
+import (
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+)
+
+// SettlementType identifies what a Deal Settlement amount is for.
+type SettlementType string
+
+const (
+	// SettlementTypePayout is the amount due to be paid out to the
+	// merchant/seller the payment is split towards.
+	SettlementTypePayout SettlementType = "payout"
+)
+
+// Settlement is one leg of how a Payment's funds are split within a Deal.
+type Settlement struct {
+	Type   SettlementType    `json:"type,omitempty"`
+	Amount *yoocommon.Amount `json:"amount,omitempty"`
+}
+
+// Deal is the Safe Deal a Payment's funds are split through. See the Deal
+// type in yoodeal for the full deal entity (status, balance, ...); this is
+// only the reference YooMoney embeds in the Payment itself.
+type Deal struct {
+	ID          string       `json:"id,omitempty"`
+	Settlements []Settlement `json:"settlements,omitempty"`
+}
+
+// Transfer describes one split of a Payment's funds to a specific
+// marketplace seller, as part of a split-payment or Safe Deal flow.
+type Transfer struct {
+	// AccountID of the seller store the funds are transferred to.
+	AccountID string `json:"account_id"`
+
+	// Amount transferred to the seller.
+	Amount *yoocommon.Amount `json:"amount"`
+
+	// Status of the transfer. Possible values: pending, waiting_for_capture,
+	// succeeded, and canceled.
+	Status Status `json:"status,omitempty"`
+
+	// PlatformFeeAmount is the platform's commission, withheld from Amount.
+	PlatformFeeAmount *yoocommon.Amount `json:"platform_fee_amount,omitempty"`
+
+	// Description of the transfer, shown to the seller.
+	Description string `json:"description,omitempty"`
+
+	// Any additional data required for processing the transfer.
+	Metadata interface{} `json:"metadata,omitempty"`
+}
@@ -0,0 +1,39 @@
+package yoopayment
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPaymentUnmarshalJSONWithDealAndTransfers(t *testing.T) {
+	raw := []byte(`{
+		"id": "2d3dfd16-000f-5000-9000-145f6df21d6f",
+		"amount": {"value": "100.00", "currency": "RUB"},
+		"deal": {
+			"id": "dl-123",
+			"settlements": [{"type": "payout", "amount": {"value": "90.00", "currency": "RUB"}}]
+		},
+		"transfers": [
+			{"account_id": "seller-1", "amount": {"value": "90.00", "currency": "RUB"}, "status": "succeeded"}
+		]
+	}`)
+
+	var payment Payment
+	if err := json.Unmarshal(raw, &payment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if payment.Deal == nil || payment.Deal.ID != "dl-123" {
+		t.Fatalf("expected deal dl-123, got %+v", payment.Deal)
+	}
+	if len(payment.Deal.Settlements) != 1 || payment.Deal.Settlements[0].Type != SettlementTypePayout {
+		t.Errorf("expected one payout settlement, got %+v", payment.Deal.Settlements)
+	}
+
+	if len(payment.Transfers) != 1 {
+		t.Fatalf("expected one transfer, got %d", len(payment.Transfers))
+	}
+	if payment.Transfers[0].AccountID != "seller-1" || payment.Transfers[0].Status != StatusSucceeded {
+		t.Errorf("unexpected transfer: %+v", payment.Transfers[0])
+	}
+}
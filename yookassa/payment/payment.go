@@ -108,22 +108,14 @@ type Payment struct {
 }
 
 func (p *Payment) GetConfirmationToken() (string, error) {
-	m, ok := p.Confirmation.(map[string]any)
+	embedded, ok := p.Confirmation.(*ConfirmationEmbedded)
 	if !ok {
-		return "", fmt.Errorf("confirmation is not a map")
+		return "", fmt.Errorf("confirmation is not an embedded confirmation, got %T", p.Confirmation)
 	}
-	raw, ok := m["confirmation_token"]
-	if !ok {
-		return "", fmt.Errorf("confirmation_token not found in confirmation map")
-	}
-	token, ok := raw.(string)
-	if !ok {
-		return "", fmt.Errorf("confirmation_token is not a string, got %T", raw)
-	}
-	if token == "" {
+	if embedded.ConfirmationToken == "" {
 		return "", fmt.Errorf("confirmation_token is empty")
 	}
-	return token, nil
+	return embedded.ConfirmationToken, nil
 }
 
 func (p *Payment) GetInvoiceIdFromMetadata() (string, error) {
@@ -146,50 +138,61 @@ func (p *Payment) GetInvoiceIdFromMetadata() (string, error) {
 }
 
 func (p *Payment) GetBasePaymentMethod() (BasePaymentMethod, error) {
-	return convertPaymentMethod[BasePaymentMethod](p.PaymentMethod)
+	if p.PaymentMethod == nil {
+		return BasePaymentMethod{}, fmt.Errorf("payment method is not set")
+	}
+	return p.PaymentMethod.Base(), nil
 }
 
 func (p *Payment) GetPaymentMethodWithCard() (PaymentMethodWithCard, error) {
-	return convertPaymentMethod[PaymentMethodWithCard](p.PaymentMethod)
+	card, ok := p.PaymentMethod.(*PaymentMethodWithCard)
+	if !ok {
+		return PaymentMethodWithCard{}, fmt.Errorf("payment method is not bank_card, got %T", p.PaymentMethod)
+	}
+	return *card, nil
 }
 
 func (p *Payment) GetPaymentMethodSbp() (SBP, error) {
-	sbp, err := convertPaymentMethod[SBP](p.PaymentMethod)
-
-	if err != nil {
-		return sbp, err
+	sbp, ok := p.PaymentMethod.(*SBP)
+	if !ok {
+		return SBP{}, fmt.Errorf("payment method is not sbp, got %T", p.PaymentMethod)
 	}
+	return *sbp, nil
+}
 
-	fmt.Println("ALE", sbp.Type)
-
-	if sbp.paymentMethod.Type != PaymentTypeSBP {
-		return sbp, fmt.Errorf("payment method is not SBP, got %s", sbp.paymentMethod.Type)
+// UnmarshalJSON decodes a Payment, resolving PaymentMethod and Confirmation
+// to their concrete types via the payment_method.type and confirmation.type
+// discriminators instead of leaving them as generic maps.
+func (p *Payment) UnmarshalJSON(data []byte) error {
+	type rawPayment Payment
+
+	aux := &struct {
+		PaymentMethod json.RawMessage `json:"payment_method,omitempty"`
+		Confirmation  json.RawMessage `json:"confirmation,omitempty"`
+		*rawPayment
+	}{
+		rawPayment: (*rawPayment)(p),
 	}
 
-	return sbp, nil
-}
-
-func convertPaymentMethod[T any](pm interface{}) (T, error) {
-	var zero T
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
 
-	switch v := pm.(type) {
-	case *T:
-		return *v, nil
-	case T:
-		return v, nil
-	case map[string]interface{}:
-		jsonData, err := json.Marshal(v)
+	if len(aux.PaymentMethod) > 0 {
+		method, err := newPaymentMethod(aux.PaymentMethod)
 		if err != nil {
-			return zero, fmt.Errorf("failed to marshal map: %w", err)
+			return err
 		}
+		p.PaymentMethod = method
+	}
 
-		var result T
-		if err := json.Unmarshal(jsonData, &result); err != nil {
-			return zero, fmt.Errorf("failed to unmarshal to %T: %w", result, err)
+	if len(aux.Confirmation) > 0 {
+		confirmation, err := newConfirmation(aux.Confirmation)
+		if err != nil {
+			return err
 		}
-
-		return result, nil
-	default:
-		return zero, fmt.Errorf("unsupported payment method type: %T", pm)
+		p.Confirmation = confirmation
 	}
+
+	return nil
 }
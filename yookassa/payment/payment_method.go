@@ -0,0 +1,279 @@
+package yoopayment
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PaymentType identifies the payment method used for a Payment.
+type PaymentType string
+
+const (
+	PaymentTypeBankCard      PaymentType = "bank_card"
+	PaymentTypeSberbank      PaymentType = "sberbank"
+	PaymentTypeYooMoney      PaymentType = "yoo_money"
+	PaymentTypeSBP           PaymentType = "sbp"
+	PaymentTypeTinkoffBank   PaymentType = "tinkoff_bank"
+	PaymentTypeSberLoan      PaymentType = "sber_loan"
+	PaymentTypeInstallments  PaymentType = "installments"
+	PaymentTypeB2BSberbank   PaymentType = "b2b_sberbank"
+	PaymentTypeMobileBalance PaymentType = "mobile_balance"
+	PaymentTypeCash          PaymentType = "cash"
+)
+
+// PaymentMethoder is implemented by every concrete payment method
+// (PaymentMethodWithCard, SBP, YooMoney, ...). Payment.UnmarshalJSON picks
+// the concrete type from the payment_method.type discriminator, so callers
+// no longer need to re-marshal a map[string]interface{} to read it.
+type PaymentMethoder interface {
+	GetType() PaymentType
+	Base() BasePaymentMethod
+}
+
+// BasePaymentMethod holds the fields common to every payment method.
+type BasePaymentMethod struct {
+	Type  PaymentType `json:"type"`
+	ID    string      `json:"id,omitempty"`
+	Saved bool        `json:"saved,omitempty"`
+	Title string      `json:"title,omitempty"`
+}
+
+func (m BasePaymentMethod) GetType() PaymentType {
+	return m.Type
+}
+
+func (m BasePaymentMethod) Base() BasePaymentMethod {
+	return m
+}
+
+// paymentMethod is the field every concrete payment method embeds to get
+// BasePaymentMethod's fields and methods under its own name, so code like
+// sbp.paymentMethod.Type (and, via promotion, sbp.Type) keeps working.
+type paymentMethod = BasePaymentMethod
+
+// Card carries the bank card details shown to the user during checkout.
+type Card struct {
+	First6        string `json:"first6,omitempty"`
+	Last4         string `json:"last4"`
+	ExpiryMonth   string `json:"expiry_month"`
+	ExpiryYear    string `json:"expiry_year"`
+	CardType      string `json:"card_type"`
+	IssuerCountry string `json:"issuer_country,omitempty"`
+	IssuerName    string `json:"issuer_name,omitempty"`
+}
+
+// PaymentMethodWithCard is the bank_card payment method.
+type PaymentMethodWithCard struct {
+	paymentMethod
+	Card *Card `json:"card,omitempty"`
+}
+
+// MarshalJSON fills in Type, so a caller building a PaymentMethodWithCard by
+// hand doesn't have to set the discriminator itself.
+func (m PaymentMethodWithCard) MarshalJSON() ([]byte, error) {
+	type alias PaymentMethodWithCard
+	if m.Type == "" {
+		m.Type = PaymentTypeBankCard
+	}
+	return json.Marshal(alias(m))
+}
+
+// SBP is the sbp payment method (Faster Payments System).
+type SBP struct {
+	paymentMethod
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m SBP) MarshalJSON() ([]byte, error) {
+	type alias SBP
+	if m.Type == "" {
+		m.Type = PaymentTypeSBP
+	}
+	return json.Marshal(alias(m))
+}
+
+// YooMoney is the yoo_money payment method.
+type YooMoney struct {
+	paymentMethod
+	AccountNumber string `json:"account_number,omitempty"`
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m YooMoney) MarshalJSON() ([]byte, error) {
+	type alias YooMoney
+	if m.Type == "" {
+		m.Type = PaymentTypeYooMoney
+	}
+	return json.Marshal(alias(m))
+}
+
+// Sberbank is the sberbank payment method (SberPay).
+type Sberbank struct {
+	paymentMethod
+	Phone string `json:"phone,omitempty"`
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m Sberbank) MarshalJSON() ([]byte, error) {
+	type alias Sberbank
+	if m.Type == "" {
+		m.Type = PaymentTypeSberbank
+	}
+	return json.Marshal(alias(m))
+}
+
+// TinkoffBank is the tinkoff_bank payment method.
+type TinkoffBank struct {
+	paymentMethod
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m TinkoffBank) MarshalJSON() ([]byte, error) {
+	type alias TinkoffBank
+	if m.Type == "" {
+		m.Type = PaymentTypeTinkoffBank
+	}
+	return json.Marshal(alias(m))
+}
+
+// SberLoan is the sber_loan payment method (an installment loan from Sberbank).
+type SberLoan struct {
+	paymentMethod
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m SberLoan) MarshalJSON() ([]byte, error) {
+	type alias SberLoan
+	if m.Type == "" {
+		m.Type = PaymentTypeSberLoan
+	}
+	return json.Marshal(alias(m))
+}
+
+// Installments is the installments payment method.
+type Installments struct {
+	paymentMethod
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m Installments) MarshalJSON() ([]byte, error) {
+	type alias Installments
+	if m.Type == "" {
+		m.Type = PaymentTypeInstallments
+	}
+	return json.Marshal(alias(m))
+}
+
+// PayerBankDetails describes the payer's organization for a B2BSberbank payment.
+type PayerBankDetails struct {
+	FullName      string `json:"full_name"`
+	ShortName     string `json:"short_name"`
+	Address       string `json:"address"`
+	Inn           string `json:"inn"`
+	Kpp           string `json:"kpp,omitempty"`
+	BankName      string `json:"bank_name"`
+	BankBranch    string `json:"bank_branch"`
+	BankAddress   string `json:"bank_address"`
+	Bic           string `json:"bank_id_code"`
+	AccountNumber string `json:"account_number"`
+}
+
+// B2BSberbank is the b2b_sberbank payment method.
+type B2BSberbank struct {
+	paymentMethod
+	PayerBankDetails *PayerBankDetails `json:"payer_bank_details,omitempty"`
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m B2BSberbank) MarshalJSON() ([]byte, error) {
+	type alias B2BSberbank
+	if m.Type == "" {
+		m.Type = PaymentTypeB2BSberbank
+	}
+	return json.Marshal(alias(m))
+}
+
+// MobileBalance is the mobile_balance payment method.
+type MobileBalance struct {
+	paymentMethod
+	Phone string `json:"phone,omitempty"`
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m MobileBalance) MarshalJSON() ([]byte, error) {
+	type alias MobileBalance
+	if m.Type == "" {
+		m.Type = PaymentTypeMobileBalance
+	}
+	return json.Marshal(alias(m))
+}
+
+// Cash is the cash payment method.
+type Cash struct {
+	paymentMethod
+}
+
+// MarshalJSON fills in Type; see PaymentMethodWithCard.MarshalJSON.
+func (m Cash) MarshalJSON() ([]byte, error) {
+	type alias Cash
+	if m.Type == "" {
+		m.Type = PaymentTypeCash
+	}
+	return json.Marshal(alias(m))
+}
+
+// UnknownPaymentMethod is decoded when payment_method.type doesn't match
+// any payment method this SDK models yet — YooKassa adds new ones over
+// time (e.g. qiwi, apple_pay, google_pay, electronic_certificate) ahead of
+// this SDK modeling them. Raw carries the undecoded payment_method object
+// so callers can still inspect it.
+type UnknownPaymentMethod struct {
+	paymentMethod
+	Raw json.RawMessage `json:"-"`
+}
+
+// newPaymentMethod decodes raw into the concrete PaymentMethoder matching
+// its "type" discriminator field.
+func newPaymentMethod(raw []byte) (PaymentMethoder, error) {
+	var discriminator struct {
+		Type PaymentType `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return nil, fmt.Errorf("yoopayment: can't read payment_method.type: %w", err)
+	}
+
+	var method PaymentMethoder
+	switch discriminator.Type {
+	case PaymentTypeBankCard:
+		method = &PaymentMethodWithCard{}
+	case PaymentTypeSBP:
+		method = &SBP{}
+	case PaymentTypeYooMoney:
+		method = &YooMoney{}
+	case PaymentTypeSberbank:
+		method = &Sberbank{}
+	case PaymentTypeTinkoffBank:
+		method = &TinkoffBank{}
+	case PaymentTypeSberLoan:
+		method = &SberLoan{}
+	case PaymentTypeInstallments:
+		method = &Installments{}
+	case PaymentTypeB2BSberbank:
+		method = &B2BSberbank{}
+	case PaymentTypeMobileBalance:
+		method = &MobileBalance{}
+	case PaymentTypeCash:
+		method = &Cash{}
+	default:
+		// YooKassa supports more payment methods than this SDK models.
+		// Decode what we can (the common fields) instead of failing the
+		// whole Payment and losing an otherwise valid page of results.
+		method = &UnknownPaymentMethod{Raw: append(json.RawMessage(nil), raw...)}
+	}
+
+	if err := json.Unmarshal(raw, method); err != nil {
+		return nil, fmt.Errorf("yoopayment: can't decode %q payment method: %w", discriminator.Type, err)
+	}
+
+	return method, nil
+}
@@ -0,0 +1,101 @@
+package yoopayment
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPaymentUnmarshalJSONDispatchesPaymentMethod(t *testing.T) {
+	raw := []byte(`{
+		"id": "2d3dfd16-000f-5000-9000-145f6df21d6f",
+		"payment_method": {
+			"type": "bank_card",
+			"id": "card-id",
+			"saved": true,
+			"card": {"last4": "4444", "expiry_month": "12", "expiry_year": "2030", "card_type": "MasterCard"}
+		},
+		"confirmation": {"type": "embedded", "confirmation_token": "abc123"}
+	}`)
+
+	var payment Payment
+	if err := json.Unmarshal(raw, &payment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	card, ok := payment.PaymentMethod.(*PaymentMethodWithCard)
+	if !ok {
+		t.Fatalf("expected *PaymentMethodWithCard, got %T", payment.PaymentMethod)
+	}
+	if card.Card.Last4 != "4444" {
+		t.Errorf("expected card last4 4444, got %s", card.Card.Last4)
+	}
+
+	token, err := payment.GetConfirmationToken()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "abc123" {
+		t.Errorf("expected confirmation token abc123, got %s", token)
+	}
+}
+
+func TestPaymentUnmarshalJSONUnknownPaymentMethod(t *testing.T) {
+	raw := []byte(`{"id": "id", "payment_method": {"type": "future_method", "id": "fm-id"}}`)
+
+	var payment Payment
+	if err := json.Unmarshal(raw, &payment); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unknown, ok := payment.PaymentMethod.(*UnknownPaymentMethod)
+	if !ok {
+		t.Fatalf("expected *UnknownPaymentMethod, got %T", payment.PaymentMethod)
+	}
+	if unknown.Type != "future_method" {
+		t.Errorf("expected type future_method, got %s", unknown.Type)
+	}
+	if unknown.ID != "fm-id" {
+		t.Errorf("expected id fm-id, got %s", unknown.ID)
+	}
+	if len(unknown.Raw) == 0 {
+		t.Errorf("expected Raw to carry the undecoded payment_method object")
+	}
+}
+
+func TestPaymentMethodMarshalJSONFillsInType(t *testing.T) {
+	method := &PaymentMethodWithCard{}
+
+	data, err := json.Marshal(method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded["type"] != string(PaymentTypeBankCard) {
+		t.Errorf("expected type %q, got %v (full json: %s)", PaymentTypeBankCard, decoded["type"], data)
+	}
+}
+
+func TestPaymentMethodMarshalJSONKeepsExplicitType(t *testing.T) {
+	// The explicit type differs from the one SBP.MarshalJSON would fill in,
+	// so the assertion tells a kept type from an overwritten one.
+	explicitType := PaymentType("future_method")
+	method := &SBP{}
+	method.Type = explicitType
+
+	data, err := json.Marshal(method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded["type"] != string(explicitType) {
+		t.Errorf("expected type %q, got %v (full json: %s)", explicitType, decoded["type"], data)
+	}
+}
@@ -0,0 +1,27 @@
+package yoopayment
+
+import (
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+)
+
+// ReceiptCustomer identifies who a fiscal Receipt is issued to.
+type ReceiptCustomer struct {
+	FullName string `json:"full_name,omitempty"`
+	INN      string `json:"inn,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+}
+
+// Receipt describes the fiscal receipt to generate for a Payment, as
+// required by Russian tax law (54-FZ).
+type Receipt struct {
+	// Customer the receipt is issued to.
+	Customer *ReceiptCustomer `json:"customer,omitempty"`
+
+	// Items being purchased.
+	Items []yoocommon.Item `json:"items"`
+
+	// TaxSystemCode is the merchant's taxation system, required when the
+	// merchant has more than one registered with the Federal Tax Service.
+	TaxSystemCode int `json:"tax_system_code,omitempty"`
+}
@@ -0,0 +1,9 @@
+package yoopayment
+
+// Recipient identifies who receives a Payment: the shop account and,
+// for marketplaces routing through several gateways, the specific
+// gateway it was processed through.
+type Recipient struct {
+	AccountID string `json:"account_id,omitempty"`
+	GatewayID string `json:"gateway_id,omitempty"`
+}
@@ -0,0 +1,12 @@
+package yoopayment
+
+// Status is the lifecycle status of a Payment, a Transfer within it, or its
+// receipt registration.
+type Status string
+
+const (
+	StatusPending           Status = "pending"
+	StatusWaitingForCapture Status = "waiting_for_capture"
+	StatusSucceeded         Status = "succeeded"
+	StatusCanceled          Status = "canceled"
+)
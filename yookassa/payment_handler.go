@@ -0,0 +1,164 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+	yoopayment "github.com/ilya2204/yookassa-sdk-go/yookassa/payment"
+)
+
+// PaymentHandler works with the /payments endpoint.
+type PaymentHandler struct {
+	client         *Client
+	idempotencyKey string
+}
+
+// NewPaymentHandler creates a PaymentHandler bound to client.
+func NewPaymentHandler(client *Client) *PaymentHandler {
+	return &PaymentHandler{client: client}
+}
+
+// SetIdempotencyKey returns a copy of h that sends idempotencyKey on its
+// next request; h itself is left untouched so the key never leaks into a
+// later, unrelated call.
+func (h *PaymentHandler) SetIdempotencyKey(idempotencyKey string) *PaymentHandler {
+	clone := *h
+	clone.idempotencyKey = idempotencyKey
+	return &clone
+}
+
+// PaymentParameters is the request body for creating a payment.
+type PaymentParameters struct {
+	// Payment amount.
+	Amount *yoocommon.Amount `json:"amount"`
+
+	// Description of the transaction (maximum 128 characters).
+	Description string `json:"description,omitempty" binding:"max=128"`
+
+	// Capture defines automatic acceptance of the payment.
+	Capture bool `json:"capture,omitempty"`
+
+	// Confirmation defines how the user confirms the payment.
+	Confirmation yoopayment.Confirmer `json:"confirmation,omitempty"`
+
+	// PaymentMethodID of a previously saved payment method.
+	PaymentMethodID string `json:"payment_method_id,omitempty"`
+
+	// SavePaymentMethod indicates whether the payment method should be saved.
+	SavePaymentMethod bool `json:"save_payment_method,omitempty"`
+
+	// MerchantCustomerID identifies the customer in the merchant's own
+	// system, such as an email address or phone number (maximum 200 characters).
+	MerchantCustomerID string `json:"merchant_customer_id,omitempty" binding:"max=200"`
+
+	// Any additional data required for processing the payment.
+	Metadata interface{} `json:"metadata,omitempty"`
+}
+
+// CreatePayment creates a new payment.
+func (h *PaymentHandler) CreatePayment(ctx context.Context, params *PaymentParameters) (*yoopayment.Payment, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("yookassa: can't marshal payment parameters: %w", err)
+	}
+
+	resp, err := h.client.do(ctx, http.MethodPost, "payments", body, nil, h.idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePayment(resp)
+}
+
+// GetPayment fetches a payment by ID.
+func (h *PaymentHandler) GetPayment(ctx context.Context, paymentID string) (*yoopayment.Payment, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, fmt.Sprintf("payments/%s", paymentID), nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePayment(resp)
+}
+
+// CapturePayment confirms a payment that is waiting_for_capture. amount, if
+// non-nil, captures less than the original authorized amount.
+func (h *PaymentHandler) CapturePayment(ctx context.Context, paymentID string, amount *yoocommon.Amount) (*yoopayment.Payment, error) {
+	body, err := json.Marshal(struct {
+		Amount *yoocommon.Amount `json:"amount,omitempty"`
+	}{Amount: amount})
+	if err != nil {
+		return nil, fmt.Errorf("yookassa: can't marshal capture parameters: %w", err)
+	}
+
+	resp, err := h.client.do(ctx, http.MethodPost, fmt.Sprintf("payments/%s/capture", paymentID), body, nil, h.idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePayment(resp)
+}
+
+// CancelPayment cancels a payment that is waiting_for_capture.
+func (h *PaymentHandler) CancelPayment(ctx context.Context, paymentID string) (*yoopayment.Payment, error) {
+	resp, err := h.client.do(ctx, http.MethodPost, fmt.Sprintf("payments/%s/cancel", paymentID), []byte("{}"), nil, h.idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePayment(resp)
+}
+
+// PaymentListParameters filters and paginates ListPayments.
+type PaymentListParameters struct {
+	Limit  int
+	Cursor string
+}
+
+func (p PaymentListParameters) toQueryParams() map[string]interface{} {
+	params := make(map[string]interface{})
+	if p.Limit > 0 {
+		params["limit"] = p.Limit
+	}
+	if p.Cursor != "" {
+		params["cursor"] = p.Cursor
+	}
+	return params
+}
+
+// PaymentList is a page of payments, as returned by ListPayments.
+type PaymentList struct {
+	Type       string               `json:"type"`
+	Items      []yoopayment.Payment `json:"items"`
+	NextCursor string               `json:"next_cursor,omitempty"`
+}
+
+// ListPayments lists payments, filtered and paginated by params.
+func (h *PaymentHandler) ListPayments(ctx context.Context, params PaymentListParameters) (*PaymentList, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, "payments", nil, params.toQueryParams(), "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var list PaymentList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode payment list: %w", err)
+	}
+
+	return &list, nil
+}
+
+func decodePayment(resp *http.Response) (*yoopayment.Payment, error) {
+	var payment yoopayment.Payment
+	if err := json.NewDecoder(resp.Body).Decode(&payment); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode payment: %w", err)
+	}
+	return &payment, nil
+}
@@ -0,0 +1,131 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+	yoopayment "github.com/ilya2204/yookassa-sdk-go/yookassa/payment"
+)
+
+func TestCreatePaymentSendsConfirmationWithDiscriminator(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("server: can't decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "pay-1",
+			"status": "pending",
+			"amount": {"value": "10.00", "currency": "RUB"},
+			"confirmation": {"type": "redirect", "confirmation_url": "https://yoomoney.ru/confirm"}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	params := &PaymentParameters{
+		Amount:       &yoocommon.Amount{Value: "10.00", Currency: "RUB"},
+		Capture:      true,
+		Confirmation: &yoopayment.ConfirmationRedirect{ReturnURL: "https://example.com/return"},
+	}
+
+	payment, err := client.Payments().CreatePayment(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("expected POST, got %s", gotMethod)
+	}
+	if gotPath != "/v3/payments" {
+		t.Errorf("expected path /v3/payments, got %s", gotPath)
+	}
+
+	confirmation, ok := gotBody["confirmation"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a confirmation object in the request body, got %v", gotBody["confirmation"])
+	}
+	if confirmation["type"] != "redirect" {
+		t.Errorf("expected confirmation.type redirect, got %v (request body: %v)", confirmation["type"], gotBody)
+	}
+	if confirmation["return_url"] != "https://example.com/return" {
+		t.Errorf("expected return_url to round-trip, got %v", confirmation["return_url"])
+	}
+
+	if payment.ID != "pay-1" {
+		t.Errorf("expected payment id pay-1, got %s", payment.ID)
+	}
+}
+
+func TestCapturePaymentPostsToCaptureEndpoint(t *testing.T) {
+	var gotMethod, gotPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "pay-1", "status": "succeeded"}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	payment, err := client.Payments().CapturePayment(context.Background(), "pay-1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("expected POST, got %s", gotMethod)
+	}
+	if gotPath != "/v3/payments/pay-1/capture" {
+		t.Errorf("expected path /v3/payments/pay-1/capture, got %s", gotPath)
+	}
+	if payment.Status != "succeeded" {
+		t.Errorf("expected status succeeded, got %s", payment.Status)
+	}
+}
+
+func TestListPaymentsSendsQueryParams(t *testing.T) {
+	var gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"type": "list", "items": []}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	_, err := client.Payments().ListPayments(context.Background(), PaymentListParameters{Limit: 5, Cursor: "next"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query, err := url.ParseQuery(gotQuery)
+	if err != nil {
+		t.Fatalf("can't parse query: %v", err)
+	}
+	if query.Get("limit") != "5" {
+		t.Errorf("expected limit=5, got %v", query)
+	}
+	if query.Get("cursor") != "next" {
+		t.Errorf("expected cursor=next, got %v", query)
+	}
+}
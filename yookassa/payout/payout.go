@@ -0,0 +1,127 @@
+// Package yoopayout describes the entities for working with YooMoney Payouts.
+package yoopayout
+
+import (
+	"encoding/json"
+	"time"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+)
+
+// Status is the lifecycle status of a Payout.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusSucceeded Status = "succeeded"
+	StatusCanceled  Status = "canceled"
+)
+
+// Payout is a single payment made by the merchant to an individual, such as
+// a marketplace seller or a gig-economy worker.
+type Payout struct {
+	// Payout ID in YooMoney.
+	ID string `json:"id,omitempty"`
+
+	// Payout status. Possible values: pending, succeeded, and canceled.
+	Status Status `json:"status,omitempty"`
+
+	// Payout amount.
+	Amount *yoocommon.Amount `json:"amount,omitempty"`
+
+	// Where the payout is sent: a bank card, SBP, or a YooMoney wallet.
+	PayoutDestination PayoutDestinationer `json:"payout_destination,omitempty"`
+
+	// Description of the payout (maximum 128 characters), shown to the recipient.
+	Description string `json:"description,omitempty" binding:"max=128"`
+
+	// The deal the payout is paid out of, for marketplace/Safe Deal scenarios.
+	Deal *PayoutDeal `json:"deal,omitempty"`
+
+	// SelfEmployed identifies the self-employed recipient, when required by
+	// Russian tax law (422-FZ).
+	SelfEmployed *SelfEmployed `json:"self_employed,omitempty"`
+
+	// Time the payout was created, in ISO 8601 format.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Commentary to the canceled status: who and why canceled the payout.
+	CancellationDetails *PayoutCancellationDetails `json:"cancellation_details,omitempty"`
+
+	// The attribute of a test transaction.
+	Test bool `json:"test,omitempty"`
+
+	// Any additional data required for processing the payout.
+	Metadata interface{} `json:"metadata,omitempty"`
+}
+
+// PayoutDeal links the payout to the Safe Deal it is paid out of.
+type PayoutDeal struct {
+	ID string `json:"id,omitempty"`
+}
+
+// SelfEmployed identifies the self-employed individual receiving the payout.
+type SelfEmployed struct {
+	ID     string            `json:"id,omitempty"`
+	Amount *yoocommon.Amount `json:"amount,omitempty"`
+}
+
+// PayoutCancellationDetails explains who canceled the payout and why.
+type PayoutCancellationDetails struct {
+	Party  string `json:"party,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// PayoutParameters is the request body for creating a payout.
+type PayoutParameters struct {
+	// Payout amount.
+	Amount *yoocommon.Amount `json:"amount"`
+
+	// PayoutDestinationData describes where to send the payout. Omit this
+	// and set PayoutToken instead when reusing a destination the payer
+	// already confirmed.
+	PayoutDestinationData PayoutDestinationer `json:"payout_destination_data,omitempty"`
+
+	// PayoutToken is a single-use token obtained via the widget, identifying
+	// a previously confirmed payout destination.
+	PayoutToken string `json:"payout_token,omitempty"`
+
+	// Description of the payout (maximum 128 characters).
+	Description string `json:"description,omitempty" binding:"max=128"`
+
+	// Deal the payout is paid out of.
+	Deal *PayoutDeal `json:"deal,omitempty"`
+
+	// SelfEmployed identifies the self-employed recipient.
+	SelfEmployed *SelfEmployed `json:"self_employed,omitempty"`
+
+	// Any additional data required for processing the payout.
+	Metadata interface{} `json:"metadata,omitempty"`
+}
+
+// UnmarshalJSON decodes a Payout, resolving PayoutDestination to its
+// concrete type via the payout_destination.type discriminator.
+func (p *Payout) UnmarshalJSON(data []byte) error {
+	type rawPayout Payout
+
+	aux := &struct {
+		PayoutDestination json.RawMessage `json:"payout_destination,omitempty"`
+		*rawPayout
+	}{
+		rawPayout: (*rawPayout)(p),
+	}
+
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+
+	if len(aux.PayoutDestination) > 0 {
+		destination, err := newPayoutDestination(aux.PayoutDestination)
+		if err != nil {
+			return err
+		}
+		p.PayoutDestination = destination
+	}
+
+	return nil
+}
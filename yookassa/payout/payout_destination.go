@@ -0,0 +1,91 @@
+package yoopayout
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PayoutDestinationType identifies where a Payout is sent.
+type PayoutDestinationType string
+
+const (
+	PayoutDestinationTypeBankCard PayoutDestinationType = "bank_card"
+	PayoutDestinationTypeSBP      PayoutDestinationType = "sbp"
+	PayoutDestinationTypeYooMoney PayoutDestinationType = "yoo_money"
+)
+
+// PayoutDestinationer is implemented by every concrete payout destination
+// (BankCardPayoutDestination, SBPPayoutDestination, YooMoneyPayoutDestination).
+type PayoutDestinationer interface {
+	GetType() PayoutDestinationType
+}
+
+type basePayoutDestination struct {
+	Type PayoutDestinationType `json:"type"`
+}
+
+func (d basePayoutDestination) GetType() PayoutDestinationType {
+	return d.Type
+}
+
+// Card carries the bank card details a payout is sent to.
+type Card struct {
+	First6 string `json:"first6,omitempty"`
+	Last4  string `json:"last4"`
+}
+
+// BankCardPayoutDestination sends the payout to a bank card.
+type BankCardPayoutDestination struct {
+	basePayoutDestination
+	Card *Card `json:"card,omitempty"`
+}
+
+// SBPPayoutDestination sends the payout via the Faster Payments System.
+type SBPPayoutDestination struct {
+	basePayoutDestination
+	BankID string `json:"bank_id,omitempty"`
+	Phone  string `json:"phone,omitempty"`
+}
+
+// YooMoneyPayoutDestination sends the payout to a YooMoney wallet.
+type YooMoneyPayoutDestination struct {
+	basePayoutDestination
+	AccountNumber string `json:"account_number,omitempty"`
+}
+
+// UnknownPayoutDestination is decoded when payout_destination.type doesn't
+// match any destination this SDK models yet. Raw carries the undecoded
+// payout destination object so callers can still inspect it.
+type UnknownPayoutDestination struct {
+	basePayoutDestination
+	Raw json.RawMessage `json:"-"`
+}
+
+func newPayoutDestination(raw []byte) (PayoutDestinationer, error) {
+	var discriminator struct {
+		Type PayoutDestinationType `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return nil, fmt.Errorf("yoopayout: can't read payout_destination.type: %w", err)
+	}
+
+	var destination PayoutDestinationer
+	switch discriminator.Type {
+	case PayoutDestinationTypeBankCard:
+		destination = &BankCardPayoutDestination{}
+	case PayoutDestinationTypeSBP:
+		destination = &SBPPayoutDestination{}
+	case PayoutDestinationTypeYooMoney:
+		destination = &YooMoneyPayoutDestination{}
+	default:
+		// YooKassa may add destination types this SDK doesn't model yet;
+		// decode what we can instead of failing the whole Payout.
+		destination = &UnknownPayoutDestination{Raw: append(json.RawMessage(nil), raw...)}
+	}
+
+	if err := json.Unmarshal(raw, destination); err != nil {
+		return nil, fmt.Errorf("yoopayout: can't decode %q payout destination: %w", discriminator.Type, err)
+	}
+
+	return destination, nil
+}
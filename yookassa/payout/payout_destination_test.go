@@ -0,0 +1,23 @@
+package yoopayout
+
+import "testing"
+
+func TestNewPayoutDestinationUnknownType(t *testing.T) {
+	raw := []byte(`{"type": "future_destination"}`)
+
+	destination, err := newPayoutDestination(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unknown, ok := destination.(*UnknownPayoutDestination)
+	if !ok {
+		t.Fatalf("expected *UnknownPayoutDestination, got %T", destination)
+	}
+	if unknown.GetType() != "future_destination" {
+		t.Errorf("expected type future_destination, got %s", unknown.GetType())
+	}
+	if len(unknown.Raw) == 0 {
+		t.Errorf("expected Raw to carry the undecoded payout destination object")
+	}
+}
@@ -0,0 +1,105 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	yoopayout "github.com/ilya2204/yookassa-sdk-go/yookassa/payout"
+)
+
+// PayoutHandler works with the /payouts endpoint.
+type PayoutHandler struct {
+	client         *Client
+	idempotencyKey string
+}
+
+// NewPayoutHandler creates a PayoutHandler bound to client.
+func NewPayoutHandler(client *Client) *PayoutHandler {
+	return &PayoutHandler{client: client}
+}
+
+// SetIdempotencyKey returns a copy of h that sends idempotencyKey on its
+// next request; h itself is left untouched so the key never leaks into a
+// later, unrelated call.
+func (h *PayoutHandler) SetIdempotencyKey(idempotencyKey string) *PayoutHandler {
+	clone := *h
+	clone.idempotencyKey = idempotencyKey
+	return &clone
+}
+
+// CreatePayout creates a new payout to a bank card, SBP, or YooMoney wallet.
+func (h *PayoutHandler) CreatePayout(ctx context.Context, params *yoopayout.PayoutParameters) (*yoopayout.Payout, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("yookassa: can't marshal payout parameters: %w", err)
+	}
+
+	resp, err := h.client.do(ctx, http.MethodPost, "payouts", body, nil, h.idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePayout(resp)
+}
+
+// GetPayout fetches a payout by ID.
+func (h *PayoutHandler) GetPayout(ctx context.Context, payoutID string) (*yoopayout.Payout, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, fmt.Sprintf("payouts/%s", payoutID), nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodePayout(resp)
+}
+
+// PayoutListParameters filters and paginates ListPayouts.
+type PayoutListParameters struct {
+	Limit  int
+	Cursor string
+}
+
+func (p PayoutListParameters) toQueryParams() map[string]interface{} {
+	params := make(map[string]interface{})
+	if p.Limit > 0 {
+		params["limit"] = p.Limit
+	}
+	if p.Cursor != "" {
+		params["cursor"] = p.Cursor
+	}
+	return params
+}
+
+// PayoutList is a page of payouts, as returned by ListPayouts.
+type PayoutList struct {
+	Type       string             `json:"type"`
+	Items      []yoopayout.Payout `json:"items"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+// ListPayouts lists payouts, filtered and paginated by params.
+func (h *PayoutHandler) ListPayouts(ctx context.Context, params PayoutListParameters) (*PayoutList, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, "payouts", nil, params.toQueryParams(), "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var list PayoutList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode payout list: %w", err)
+	}
+
+	return &list, nil
+}
+
+func decodePayout(resp *http.Response) (*yoopayout.Payout, error) {
+	var payout yoopayout.Payout
+	if err := json.NewDecoder(resp.Body).Decode(&payout); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode payout: %w", err)
+	}
+	return &payout, nil
+}
@@ -0,0 +1,116 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+	yoopayout "github.com/ilya2204/yookassa-sdk-go/yookassa/payout"
+)
+
+func TestPayoutHandlerIdempotencyKey(t *testing.T) {
+	idempotencyKey := uuid.NewString()
+
+	payoutHandler := NewPayoutHandler(nil)
+
+	payoutHandler.SetIdempotencyKey(idempotencyKey)
+
+	if payoutHandler.idempotencyKey != "" {
+		t.Errorf("Idempotency key must be set only for one request")
+	}
+
+	if payoutHandler.SetIdempotencyKey(idempotencyKey).idempotencyKey != idempotencyKey {
+		t.Errorf("Wrong behaviour of idempotency key: %s", idempotencyKey)
+	}
+
+	if payoutHandler.idempotencyKey != "" {
+		t.Errorf("Idempotency key must be set only for one request")
+	}
+}
+
+func TestCreatePayoutPostsExpectedBody(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("server: can't decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "payout-1",
+			"status": "pending",
+			"amount": {"value": "100.00", "currency": "RUB"},
+			"payout_destination": {"type": "bank_card", "card": {"last4": "4444"}}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	params := &yoopayout.PayoutParameters{
+		Amount: &yoocommon.Amount{Value: "100.00", Currency: "RUB"},
+		PayoutDestinationData: &yoopayout.BankCardPayoutDestination{
+			Card: &yoopayout.Card{Last4: "4444"},
+		},
+	}
+
+	payout, err := client.Payouts().CreatePayout(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("expected POST, got %s", gotMethod)
+	}
+	if gotPath != "/v3/payouts" {
+		t.Errorf("expected path /v3/payouts, got %s", gotPath)
+	}
+	if _, ok := gotBody["payout_destination_data"]; !ok {
+		t.Errorf("expected payout_destination_data in the request body, got %v", gotBody)
+	}
+
+	if payout.ID != "payout-1" {
+		t.Errorf("expected payout id payout-1, got %s", payout.ID)
+	}
+}
+
+func TestListPayoutsSendsQueryParams(t *testing.T) {
+	var gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"type": "list", "items": []}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	_, err := client.Payouts().ListPayouts(context.Background(), PayoutListParameters{Limit: 3, Cursor: "c1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query, err := url.ParseQuery(gotQuery)
+	if err != nil {
+		t.Fatalf("can't parse query: %v", err)
+	}
+	if query.Get("limit") != "3" {
+		t.Errorf("expected limit=3, got %v", query)
+	}
+	if query.Get("cursor") != "c1" {
+		t.Errorf("expected cursor=c1, got %v", query)
+	}
+}
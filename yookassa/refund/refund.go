@@ -0,0 +1,48 @@
+// Package yoorefund describes the entities for working with YooMoney Refunds.
+package yoorefund
+
+import (
+	"time"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+)
+
+// Status is the lifecycle status of a Refund.
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusSucceeded Status = "succeeded"
+	StatusCanceled  Status = "canceled"
+)
+
+// Refund is a refund of a previously succeeded Payment, in full or in part.
+type Refund struct {
+	// Refund ID in YooMoney.
+	ID string `json:"id,omitempty"`
+
+	// ID of the payment being refunded.
+	PaymentID string `json:"payment_id,omitempty"`
+
+	// Refund status. Possible values: pending, succeeded, and canceled.
+	Status Status `json:"status,omitempty"`
+
+	// Refund amount. May be less than the payment amount for a partial refund.
+	Amount *yoocommon.Amount `json:"amount,omitempty"`
+
+	// Time the refund was created, in ISO 8601 format.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Commentary on the reason for the refund, shown to the user.
+	Description string `json:"description,omitempty" binding:"max=128"`
+
+	// Commentary to the canceled status: who and why canceled the refund.
+	CancellationDetails *yoocommon.CancellationDetails `json:"cancellation_details,omitempty"`
+}
+
+// RefundParameters is the request body for creating a refund.
+type RefundParameters struct {
+	PaymentID   string            `json:"payment_id"`
+	Amount      *yoocommon.Amount `json:"amount"`
+	Description string            `json:"description,omitempty" binding:"max=128"`
+}
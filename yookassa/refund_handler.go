@@ -0,0 +1,109 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	yoorefund "github.com/ilya2204/yookassa-sdk-go/yookassa/refund"
+)
+
+// RefundHandler works with the /refunds endpoint.
+type RefundHandler struct {
+	client         *Client
+	idempotencyKey string
+}
+
+// NewRefundHandler creates a RefundHandler bound to client.
+func NewRefundHandler(client *Client) *RefundHandler {
+	return &RefundHandler{client: client}
+}
+
+// SetIdempotencyKey returns a copy of h that sends idempotencyKey on its
+// next request; h itself is left untouched so the key never leaks into a
+// later, unrelated call.
+func (h *RefundHandler) SetIdempotencyKey(idempotencyKey string) *RefundHandler {
+	clone := *h
+	clone.idempotencyKey = idempotencyKey
+	return &clone
+}
+
+// CreateRefund refunds a succeeded payment, in full or in part.
+func (h *RefundHandler) CreateRefund(ctx context.Context, params *yoorefund.RefundParameters) (*yoorefund.Refund, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("yookassa: can't marshal refund parameters: %w", err)
+	}
+
+	resp, err := h.client.do(ctx, http.MethodPost, "refunds", body, nil, h.idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeRefund(resp)
+}
+
+// GetRefund fetches a refund by ID.
+func (h *RefundHandler) GetRefund(ctx context.Context, refundID string) (*yoorefund.Refund, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, fmt.Sprintf("refunds/%s", refundID), nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return decodeRefund(resp)
+}
+
+// RefundListParameters filters and paginates ListRefunds.
+type RefundListParameters struct {
+	PaymentID string
+	Limit     int
+	Cursor    string
+}
+
+func (p RefundListParameters) toQueryParams() map[string]interface{} {
+	params := make(map[string]interface{})
+	if p.PaymentID != "" {
+		params["payment_id"] = p.PaymentID
+	}
+	if p.Limit > 0 {
+		params["limit"] = p.Limit
+	}
+	if p.Cursor != "" {
+		params["cursor"] = p.Cursor
+	}
+	return params
+}
+
+// RefundList is a page of refunds, as returned by ListRefunds.
+type RefundList struct {
+	Type       string             `json:"type"`
+	Items      []yoorefund.Refund `json:"items"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+}
+
+// ListRefunds lists refunds, filtered and paginated by params.
+func (h *RefundHandler) ListRefunds(ctx context.Context, params RefundListParameters) (*RefundList, error) {
+	resp, err := h.client.do(ctx, http.MethodGet, "refunds", nil, params.toQueryParams(), "")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var list RefundList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode refund list: %w", err)
+	}
+
+	return &list, nil
+}
+
+func decodeRefund(resp *http.Response) (*yoorefund.Refund, error) {
+	var refund yoorefund.Refund
+	if err := json.NewDecoder(resp.Body).Decode(&refund); err != nil {
+		return nil, fmt.Errorf("yookassa: can't decode refund: %w", err)
+	}
+	return &refund, nil
+}
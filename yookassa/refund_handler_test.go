@@ -0,0 +1,134 @@
+package yookassa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+
+	yoocommon "github.com/ilya2204/yookassa-sdk-go/yookassa/common"
+	yoorefund "github.com/ilya2204/yookassa-sdk-go/yookassa/refund"
+)
+
+func TestRefundHandlerIdempotencyKey(t *testing.T) {
+	idempotencyKey := uuid.NewString()
+
+	refundHandler := NewRefundHandler(nil)
+
+	refundHandler.SetIdempotencyKey(idempotencyKey)
+
+	if refundHandler.idempotencyKey != "" {
+		t.Errorf("Idempotency key must be set only for one request")
+	}
+
+	if refundHandler.SetIdempotencyKey(idempotencyKey).idempotencyKey != idempotencyKey {
+		t.Errorf("Wrong behaviour of idempotency key: %s", idempotencyKey)
+	}
+
+	if refundHandler.idempotencyKey != "" {
+		t.Errorf("Idempotency key must be set only for one request")
+	}
+}
+
+func TestCreateRefundPostsExpectedBody(t *testing.T) {
+	var gotMethod, gotPath string
+	var gotBody map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatalf("server: can't decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "refund-1",
+			"payment_id": "pay-1",
+			"status": "succeeded",
+			"amount": {"value": "5.00", "currency": "RUB"}
+		}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	params := &yoorefund.RefundParameters{
+		PaymentID: "pay-1",
+		Amount:    &yoocommon.Amount{Value: "5.00", Currency: "RUB"},
+	}
+
+	refund, err := client.Refunds().CreateRefund(context.Background(), params)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotMethod != http.MethodPost {
+		t.Errorf("expected POST, got %s", gotMethod)
+	}
+	if gotPath != "/v3/refunds" {
+		t.Errorf("expected path /v3/refunds, got %s", gotPath)
+	}
+	if gotBody["payment_id"] != "pay-1" {
+		t.Errorf("expected payment_id pay-1, got %v (request body: %v)", gotBody["payment_id"], gotBody)
+	}
+
+	if refund.ID != "refund-1" {
+		t.Errorf("expected refund id refund-1, got %s", refund.ID)
+	}
+	if refund.Status != yoorefund.StatusSucceeded {
+		t.Errorf("expected status succeeded, got %s", refund.Status)
+	}
+}
+
+func TestListRefundsSendsQueryParams(t *testing.T) {
+	var gotQuery string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"type": "list", "items": []}`))
+	}))
+	defer server.Close()
+
+	client := testClient(t, server, ClientOptions{})
+
+	_, err := client.Refunds().ListRefunds(context.Background(), RefundListParameters{PaymentID: "pay-1", Limit: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query, err := url.ParseQuery(gotQuery)
+	if err != nil {
+		t.Fatalf("can't parse query: %v", err)
+	}
+	if query.Get("payment_id") != "pay-1" {
+		t.Errorf("expected payment_id=pay-1, got %v", query)
+	}
+	if query.Get("limit") != "10" {
+		t.Errorf("expected limit=10, got %v", query)
+	}
+}
+
+func TestClientHandlerAccessorsAreBoundToClient(t *testing.T) {
+	client := NewClient("account", "secret")
+
+	if client.Payments().client != client {
+		t.Errorf("Payments() handler is not bound to the originating client")
+	}
+	if client.Refunds().client != client {
+		t.Errorf("Refunds() handler is not bound to the originating client")
+	}
+	if client.Payouts().client != client {
+		t.Errorf("Payouts() handler is not bound to the originating client")
+	}
+	if client.Deals().client != client {
+		t.Errorf("Deals() handler is not bound to the originating client")
+	}
+}
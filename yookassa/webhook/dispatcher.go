@@ -0,0 +1,43 @@
+package yoowebhook
+
+import "errors"
+
+// Callback handles a single decoded notification.
+type Callback func(n *Notification) error
+
+// ErrNoCallback is returned by Dispatch when n.Event has no registered
+// callback. A merchant's YooKassa event subscriptions are configured in
+// the dashboard, independently of what's wired into the Dispatcher in
+// code, so this is expected and not a processing failure: Handler.ServeHTTP
+// treats it as a successful notification, not an error.
+var ErrNoCallback = errors.New("yoowebhook: no callback registered for event")
+
+// Dispatcher routes notifications to the callback registered for their
+// Event. It has no default behavior for unregistered events: callers
+// decide via On whether to opt in per event, per object type, or with a
+// single catch-all registered against every Event they care about.
+type Dispatcher struct {
+	callbacks map[Event]Callback
+}
+
+// NewDispatcher creates an empty Dispatcher.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{callbacks: make(map[Event]Callback)}
+}
+
+// On registers callback to run for every notification whose Event matches
+// event, replacing any callback previously registered for it.
+func (d *Dispatcher) On(event Event, callback Callback) *Dispatcher {
+	d.callbacks[event] = callback
+	return d
+}
+
+// Dispatch runs the callback registered for n.Event, if any. It returns
+// ErrNoCallback, not a processing error, when none is registered.
+func (d *Dispatcher) Dispatch(n *Notification) error {
+	callback, ok := d.callbacks[n.Event]
+	if !ok {
+		return ErrNoCallback
+	}
+	return callback(n)
+}
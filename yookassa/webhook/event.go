@@ -0,0 +1,42 @@
+// Package yoowebhook implements the receive-side of the YooKassa HTTP
+// notifications API: parsing notification envelopes, dispatching them to
+// per-event callbacks, and validating that they originate from YooKassa.
+package yoowebhook
+
+import "encoding/json"
+
+// Event identifies the kind of notification YooKassa sent, as the
+// concatenation of the object type and what happened to it
+// (e.g. "payment.succeeded").
+type Event string
+
+const (
+	EventPaymentWaitingForCapture Event = "payment.waiting_for_capture"
+	EventPaymentSucceeded         Event = "payment.succeeded"
+	EventPaymentCanceled          Event = "payment.canceled"
+	EventRefundSucceeded          Event = "refund.succeeded"
+	EventDealClosed               Event = "deal.closed"
+	EventPayoutSucceeded          Event = "payout.succeeded"
+	EventPayoutCanceled           Event = "payout.canceled"
+)
+
+// Notification is the raw envelope YooKassa posts to the merchant's
+// webhook URL. Object holds the affected entity (payment, refund, deal, ...)
+// and is left undecoded so callers can unmarshal it into the concrete type
+// that matches Event, via DecodeObject.
+type Notification struct {
+	Type   string          `json:"type"`
+	Event  Event           `json:"event"`
+	Object json.RawMessage `json:"object"`
+}
+
+// DecodeObject unmarshals the notification's Object into T, e.g.
+//
+//	payment, err := yoowebhook.DecodeObject[yoopayment.Payment](notification)
+func DecodeObject[T any](n *Notification) (T, error) {
+	var obj T
+	if err := json.Unmarshal(n.Object, &obj); err != nil {
+		return obj, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,75 @@
+package yoowebhook
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+// Options configures a Handler.
+type Options struct {
+	// SkipIPCheck disables the YooKassa notifier IP allowlist check.
+	// Leave this false in production; it exists for local testing.
+	SkipIPCheck bool
+
+	// TrustProxy makes the IP check honor X-Forwarded-For instead of
+	// net/http's RemoteAddr. Only set this when the handler is actually
+	// deployed behind a reverse proxy that sets the header.
+	TrustProxy bool
+}
+
+// Handler is an http.Handler that receives YooKassa webhook notifications,
+// validates their origin, and dispatches them to the registered Dispatcher
+// callbacks.
+type Handler struct {
+	opts       Options
+	dispatcher *Dispatcher
+}
+
+// NewWebhookHandler builds a Handler that dispatches notifications via
+// dispatcher, applying opts.
+func NewWebhookHandler(opts Options, dispatcher *Dispatcher) *Handler {
+	return &Handler{
+		opts:       opts,
+		dispatcher: dispatcher,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !h.opts.SkipIPCheck {
+		ip, err := clientIP(r, h.opts.TrustProxy)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := CheckIP(ip); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	var notification Notification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		http.Error(w, "malformed notification body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatcher.Dispatch(&notification); err != nil && !errors.Is(err, ErrNoCallback) {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,105 @@
+package yoowebhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeHTTPRejectsNonPost(t *testing.T) {
+	handler := NewWebhookHandler(Options{SkipIPCheck: true}, NewDispatcher())
+
+	req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected 405, got %d", rec.Code)
+	}
+}
+
+func TestServeHTTPRejectsNonAllowlistedIP(t *testing.T) {
+	handler := NewWebhookHandler(Options{}, NewDispatcher())
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{}`))
+	req.RemoteAddr = "8.8.8.8:12345"
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403, got %d", rec.Code)
+	}
+}
+
+func TestServeHTTPRejectsMalformedBody(t *testing.T) {
+	handler := NewWebhookHandler(Options{SkipIPCheck: true}, NewDispatcher())
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`not json`))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestServeHTTPDispatchesToRegisteredCallback(t *testing.T) {
+	var dispatched *Notification
+	dispatcher := NewDispatcher().On(EventPaymentSucceeded, func(n *Notification) error {
+		dispatched = n
+		return nil
+	})
+	handler := NewWebhookHandler(Options{SkipIPCheck: true}, dispatcher)
+
+	body := `{"type": "notification", "event": "payment.succeeded", "object": {"id": "pay-1"}}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if dispatched == nil {
+		t.Fatalf("expected the registered callback to run")
+	}
+	if dispatched.Event != EventPaymentSucceeded {
+		t.Errorf("expected event payment.succeeded, got %s", dispatched.Event)
+	}
+}
+
+func TestServeHTTPAcknowledgesWhenNoCallbackRegistered(t *testing.T) {
+	handler := NewWebhookHandler(Options{SkipIPCheck: true}, NewDispatcher())
+
+	body := `{"type": "notification", "event": "payment.succeeded", "object": {}}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200 for an event with no registered callback, got %d", rec.Code)
+	}
+}
+
+func TestServeHTTPReturnsUnprocessableWhenCallbackErrors(t *testing.T) {
+	dispatcher := NewDispatcher().On(EventPaymentSucceeded, func(n *Notification) error {
+		return errors.New("boom")
+	})
+	handler := NewWebhookHandler(Options{SkipIPCheck: true}, dispatcher)
+
+	body := `{"type": "notification", "event": "payment.succeeded", "object": {"id": "pay-1"}}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422 when the registered callback errors, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,81 @@
+package yoowebhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// notifierRanges are the IP ranges YooKassa documents as the source of its
+// webhook notifications.
+// See: https://yookassa.ru/developers/using-api/interaction-format#ip
+var notifierRanges = mustParseCIDRs(
+	"185.71.76.0/27",
+	"185.71.77.0/27",
+	"77.75.153.0/25",
+	"77.75.156.11/32",
+	"77.75.156.35/32",
+	"77.75.154.128/25",
+	"2a02:5180::/32",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("yoowebhook: invalid notifier CIDR %q: %v", cidr, err))
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+// CheckIP returns an error if ip does not belong to one of YooKassa's
+// documented notifier ranges.
+func CheckIP(ip net.IP) error {
+	if ip == nil {
+		return fmt.Errorf("yoowebhook: empty IP address")
+	}
+
+	for _, ipNet := range notifierRanges {
+		if ipNet.Contains(ip) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("yoowebhook: IP %s is not in the YooKassa notifier allowlist", ip)
+}
+
+// clientIP extracts the notifier's IP address from r, honoring
+// X-Forwarded-For when trustProxy is set (the handler is deployed behind a
+// reverse proxy that sets it).
+//
+// It trusts only the rightmost entry of X-Forwarded-For: that's the one
+// appended by our own reverse proxy, recording who connected to it. Every
+// entry to its left was supplied by the client and is attacker-controlled,
+// so honoring it would let a spoofed header walk straight past CheckIP.
+func clientIP(r *http.Request, trustProxy bool) (net.IP, error) {
+	if trustProxy {
+		if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+			hops := strings.Split(forwardedFor, ",")
+			last := strings.TrimSpace(hops[len(hops)-1])
+			if ip := net.ParseIP(last); ip != nil {
+				return ip, nil
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return nil, fmt.Errorf("yoowebhook: can't parse RemoteAddr %q: %w", r.RemoteAddr, err)
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, fmt.Errorf("yoowebhook: invalid RemoteAddr %q", host)
+	}
+
+	return ip, nil
+}
@@ -0,0 +1,64 @@
+package yoowebhook
+
+import (
+	"net"
+	"net/http"
+	"testing"
+)
+
+func TestCheckIP(t *testing.T) {
+	if err := CheckIP(net.ParseIP("185.71.76.5")); err != nil {
+		t.Errorf("expected notifier IP to be allowed, got error: %v", err)
+	}
+
+	if err := CheckIP(net.ParseIP("8.8.8.8")); err == nil {
+		t.Errorf("expected non-notifier IP to be rejected")
+	}
+
+	if err := CheckIP(nil); err == nil {
+		t.Errorf("expected nil IP to be rejected")
+	}
+}
+
+func TestClientIPTrustsProxyOnlyWhenEnabled(t *testing.T) {
+	req := &http.Request{
+		RemoteAddr: "203.0.113.10:12345",
+		Header:     http.Header{"X-Forwarded-For": []string{"10.0.0.1, 185.71.76.5"}},
+	}
+
+	ip, err := clientIP(req, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ip.String() != "203.0.113.10" {
+		t.Errorf("expected RemoteAddr to be used when TrustProxy is off, got %s", ip)
+	}
+
+	ip, err = clientIP(req, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ip.String() != "185.71.76.5" {
+		t.Errorf("expected last (our proxy's own) X-Forwarded-For entry to be used when TrustProxy is on, got %s", ip)
+	}
+}
+
+func TestClientIPIgnoresSpoofedLeadingForwardedFor(t *testing.T) {
+	// An attacker can set any value for the leading hops of X-Forwarded-For;
+	// only the rightmost one (appended by our own trusted proxy) may be used.
+	req := &http.Request{
+		RemoteAddr: "203.0.113.10:12345",
+		Header:     http.Header{"X-Forwarded-For": []string{"185.71.76.5, 198.51.100.7"}},
+	}
+
+	ip, err := clientIP(req, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ip.String() == "185.71.76.5" {
+		t.Errorf("spoofed leading X-Forwarded-For entry must not be trusted, got %s", ip)
+	}
+	if ip.String() != "198.51.100.7" {
+		t.Errorf("expected the trailing X-Forwarded-For entry to be used, got %s", ip)
+	}
+}